@@ -0,0 +1,90 @@
+package dhkam
+
+import (
+	"crypto/subtle"
+	"math/big"
+)
+
+// ctWindowBits is the fixed window width ctExp processes the exponent in.
+// A wider window trades a larger precomputed table for fewer squarings;
+// 4 bits (16 entries) is a standard choice for exponents this size.
+const ctWindowBits = 4
+
+// ctWindowSize is the number of entries in ctExp's precomputed table.
+const ctWindowSize = 1 << ctWindowBits
+
+// ctExp computes x^y mod m using fixed-window exponentiation whose running
+// time depends only on expBits and m's size, never on y itself: it always
+// walks expBits/ctWindowBits windows regardless of y's true bit length,
+// always squares ctWindowBits times per window, and always reads every
+// entry of its precomputed table on every window (see ctTableLookup)
+// rather than branching to the one it needs. That makes it suitable for
+// exponentiating a secret exponent, unlike big.Int.Exp, which both
+// branches on y's bits and scales its work with y's bit length.
+//
+// If y is negative, ctExp exponentiates on |y| and returns the modular
+// inverse of the result, mirroring big.Int.Exp's documented behaviour for
+// negative exponents: x and m must be relatively prime in that case.
+func ctExp(x, y, m *big.Int, expBits int) *big.Int {
+	neg := y.Sign() < 0
+	e := y
+	if neg {
+		e = new(big.Int).Neg(y)
+	}
+
+	table := make([]*big.Int, ctWindowSize)
+	table[0] = big.NewInt(1)
+	base := new(big.Int).Mod(x, m)
+	for i := 1; i < ctWindowSize; i++ {
+		table[i] = new(big.Int).Mod(new(big.Int).Mul(table[i-1], base), m)
+	}
+
+	windows := (expBits + ctWindowBits - 1) / ctWindowBits
+	r := big.NewInt(1)
+	for w := windows - 1; w >= 0; w-- {
+		for i := 0; i < ctWindowBits; i++ {
+			r.Mod(new(big.Int).Mul(r, r), m)
+		}
+		r.Mod(new(big.Int).Mul(r, ctTableLookup(table, ctWindowValue(e, w*ctWindowBits))), m)
+	}
+
+	if neg {
+		r.ModInverse(r, m)
+	}
+	return r
+}
+
+// ctWindowValue reads the ctWindowBits-wide window of e starting at bit
+// offset. big.Int.Bit returns 0 for any index at or past e's true bit
+// length, so this never needs to branch on how long e actually is.
+func ctWindowValue(e *big.Int, offset int) int32 {
+	var v int32
+	for i := ctWindowBits - 1; i >= 0; i-- {
+		v <<= 1
+		v |= int32(e.Bit(offset + i))
+	}
+	return v
+}
+
+// ctTableLookup returns table[want] without branching on want: it visits
+// every entry and uses subtle.ConstantTimeEq to fold in only the one
+// whose index matches, so the table is read identically for every
+// possible window value instead of jumping straight to the one needed.
+func ctTableLookup(table []*big.Int, want int32) *big.Int {
+	result := new(big.Int)
+	for i, entry := range table {
+		mask := subtle.ConstantTimeEq(int32(i), want)
+		result.Add(result, new(big.Int).Mul(entry, big.NewInt(int64(mask))))
+	}
+	return result
+}
+
+// ctExpBits picks the fixed window-processing width ctExp should use for
+// exponents arising from group: blind's exponent splitting produces
+// values sized to the full modulus rather than to ExponentBits, so the
+// width has to cover group.PubBytes*8 bits, rounded up to a whole window,
+// with one spare window of headroom.
+func ctExpBits(group *Group) int {
+	bits := group.PubBytes*8 + ctWindowBits
+	return ((bits + ctWindowBits - 1) / ctWindowBits) * ctWindowBits
+}
@@ -10,10 +10,10 @@ import (
 	"math/big"
 )
 
-const (
-	lenPriv = 32
-	lenPub  = 256
-)
+// lenPub is the fixed byte width of an encoded Group 14 public value,
+// equal to Group14.PubBytes; kept as a constant since it's baked into
+// the DHIES envelope layout in encrypt.go.
+const lenPub = 256
 
 var (
 	ErrBlindingFailed    = fmt.Errorf("dhkam: blinding failed")
@@ -24,13 +24,29 @@ var (
 )
 
 type PublicKey struct {
-	A *big.Int
+	A     *big.Int
+	Group *Group
+}
+
+// group returns the group a public key belongs to, defaulting to Group
+// 14 for keys that predate the Group field.
+func (pub *PublicKey) group() *Group {
+	if pub.Group != nil {
+		return pub.Group
+	}
+	return DefaultGroup
 }
 
 // ImportPublic takes a byte slice and attempts to convert it to a public
-// key, checking to make sure it's a valid key.
+// key in Group 14, checking to make sure it's a valid key.
 func ImportPublic(in []byte) (pub *PublicKey, err error) {
+	return ImportPublicInGroup(DefaultGroup, in)
+}
+
+// ImportPublicInGroup is ImportPublic for a caller-chosen group.
+func ImportPublicInGroup(group *Group, in []byte) (pub *PublicKey, err error) {
 	pub = new(PublicKey)
+	pub.Group = group
 	pub.A = new(big.Int).SetBytes(in)
 	if !pub.Valid() {
 		return nil, ErrInvalidPublicKey
@@ -38,12 +54,20 @@ func ImportPublic(in []byte) (pub *PublicKey, err error) {
 	return
 }
 
-// Valid runs sanity checks on the public key to ensure it is valid.
+// Valid runs the RFC 7919 subgroup check on the public key: it must lie
+// strictly between 1 and P-1, and raising it to the group's subgroup
+// order Q must yield 1, confirming it lies in the prime-order subgroup
+// generated by G.
 func (pub *PublicKey) Valid() bool {
-	if pub.A.BitLen() > P.BitLen() {
+	group := pub.group()
+	if pub.A.Cmp(bigOne) <= 0 {
 		return false
 	}
-	return true
+	pMinus1 := new(big.Int).Sub(group.P, bigOne)
+	if pub.A.Cmp(pMinus1) >= 0 {
+		return false
+	}
+	return new(big.Int).Exp(pub.A, group.Q, group.P).Cmp(bigOne) == 0
 }
 
 type PrivateKey struct {
@@ -73,35 +97,47 @@ func (prv *PrivateKey) generatePublic(prng io.Reader) (err error) {
 	if prv == nil {
 		return ErrInvalidPrivateKey
 	}
-	prv.PublicKey, err = generatePublicKey(prng, prv.X)
+	group := prv.group()
+	prv.PublicKey, err = generatePublicKey(prng, prv.X, group)
 	return
 }
 
-// ImportPrivate loads a byte slice into a private key and regenerates the
-// public key for it.
+// ImportPrivate loads a byte slice into a private key in Group 14 and
+// regenerates the public key for it.
 func ImportPrivate(prng io.Reader, in []byte) (prv *PrivateKey, err error) {
+	return ImportPrivateInGroup(prng, DefaultGroup, in)
+}
+
+// ImportPrivateInGroup is ImportPrivate for a caller-chosen group.
+func ImportPrivateInGroup(prng io.Reader, group *Group, in []byte) (prv *PrivateKey, err error) {
 	prv = new(PrivateKey)
 	prv.X = new(big.Int).SetBytes(in)
+	prv.PublicKey.Group = group
 	err = prv.generatePublic(prng)
 	return
 }
 
-// GenerateKey generates a new key pair.
+// GenerateKey generates a new key pair in Group 14.
 func GenerateKey(prng io.Reader) (prv *PrivateKey, err error) {
-	x := make([]byte, lenPriv)
+	return GenerateKeyInGroup(prng, DefaultGroup)
+}
+
+// GenerateKeyInGroup is GenerateKey for a caller-chosen group.
+func GenerateKeyInGroup(prng io.Reader, group *Group) (prv *PrivateKey, err error) {
+	x := make([]byte, group.ExponentBits/8)
 	_, err = io.ReadFull(prng, x)
 	if err != nil {
 		return
 	}
 	X := new(big.Int).SetBytes(x)
 	if X.Cmp(bigZero) != 1 {
-		return GenerateKey(prng)
-	} else if X.Cmp(new(big.Int).Sub(P, bigOne)) == 1 {
-		return GenerateKey(prng)
+		return GenerateKeyInGroup(prng, group)
+	} else if X.Cmp(new(big.Int).Sub(group.P, bigOne)) == 1 {
+		return GenerateKeyInGroup(prng, group)
 	}
 	prv = new(PrivateKey)
 	prv.X = X
-	prv.PublicKey, err = generatePublicKey(prng, prv.X)
+	prv.PublicKey, err = generatePublicKey(prng, prv.X, group)
 	if err == nil {
 		if !(&prv.PublicKey).Valid() {
 			err = ErrInvalidPublicKey
@@ -110,8 +146,9 @@ func GenerateKey(prng io.Reader) (prv *PrivateKey, err error) {
 	return
 }
 
-func generatePublicKey(prng io.Reader, x *big.Int) (pub PublicKey, err error) {
-	pub.A, err = blind(prng, g, x)
+func generatePublicKey(prng io.Reader, x *big.Int, group *Group) (pub PublicKey, err error) {
+	pub.Group = group
+	pub.A, err = blind(prng, group.G, x, group)
 	if err == nil && !(&pub).Valid() {
 		err = ErrInvalidPublicKey
 	}
@@ -130,26 +167,34 @@ func randBigInt(prng io.Reader, size int) (r *big.Int, err error) {
 }
 
 // Blinding carries out modular blinding for the operation
-//   y = a ^ x mod p
-// The modulus is fixed for DHKAM over group 14, so the caller needs
-// only to pass in the a and x values.
-func blind(prng io.Reader, a, x *big.Int) (y *big.Int, err error) {
-	bx := new(big.Int).Add(big2To258, x)
-
-	r, err := randBigInt(prng, lenPub)
+//
+//	y = a ^ x mod p
+//
+// using group's modulus and the sizing derived from its ExponentBits. The
+// two exponentiations it splits the operation into run through ctExp
+// rather than big.Int.Exp, so neither the split point (random per call)
+// nor the private exponent x ever varies the exponentiation's own running
+// time, only the externally-visible timing of the call as a whole.
+func blind(prng io.Reader, a, x *big.Int, group *Group) (y *big.Int, err error) {
+	bitsPlus2 := new(big.Int).Lsh(bigOne, uint(group.ExponentBits+2))
+	bx := new(big.Int).Add(bitsPlus2, x)
+
+	r, err := randBigInt(prng, group.PubBytes*8)
 	if err != nil {
 		err = ErrBlindingFailed
 		return
 	}
-	blinding := new(big.Int).Add(big2To256, r)
+	bits := new(big.Int).Lsh(bigOne, uint(group.ExponentBits))
+	blinding := new(big.Int).Add(bits, r)
 
 	bx.Sub(bx, blinding)
-	r1 := new(big.Int).Exp(a, blinding, P)
-	r2 := new(big.Int).Exp(a, bx, P)
+	expBits := ctExpBits(group)
+	r1 := ctExp(a, blinding, group.P, expBits)
+	r2 := ctExp(a, bx, group.P, expBits)
 	y = new(big.Int).Mul(r1, r2)
-	y.Mod(y, P)
+	y.Mod(y, group.P)
 
-	if y.BitLen() > P.BitLen() {
+	if y.BitLen() > group.P.BitLen() {
 		y = nil
 		err = ErrBlindingFailed
 		return
@@ -164,14 +209,52 @@ func (prv *PrivateKey) SharedKey(prng io.Reader, pub *PublicKey, size int) (sk [
 		err = ErrInvalidPublicKey
 		return
 	}
-	skBig, err := blind(prng, pub.A, prv.X)
+	if prv.group().ID != pub.group().ID {
+		err = ErrInvalidPublicKey
+		return
+	}
+	skBig, err := blind(prng, pub.A, prv.X, prv.group())
+	if err != nil {
+		return
+	}
+	raw := skBig.Bytes()
+	if len(raw) < size {
+		err = ErrInvalidSharedKey
+		return
+	}
+	sk = raw[:size]
+	return
+}
+
+// SharedKeyConstantTime is SharedKey for callers who need that guarantee
+// explicit at the call site: blind (which both methods use) already
+// computes its exponentiations through ctExp, but SharedKey still takes
+// its output bytes via skBig.Bytes()[:size], whose length and leading
+// bytes shift with skBig's own leading zero bytes. SharedKeyConstantTime
+// instead zero-pads skBig to the group's full PubBytes width first (as
+// Encrypt and DHKEM do for public values; Export itself does not pad), so
+// the bytes returned never depend on how many of skBig's leading bytes
+// happened to be zero.
+func (prv *PrivateKey) SharedKeyConstantTime(prng io.Reader, pub *PublicKey, size int) (sk []byte, err error) {
+	if !pub.Valid() {
+		err = ErrInvalidPublicKey
+		return
+	}
+	group := prv.group()
+	if group.ID != pub.group().ID {
+		err = ErrInvalidPublicKey
+		return
+	}
+	skBig, err := blind(prng, pub.A, prv.X, group)
 	if err != nil {
 		return
 	}
-	sk = skBig.Bytes()[:size]
-	if len(sk) < size {
+	padded := zeroPad(skBig.Bytes(), group.PubBytes)
+	if size > len(padded) {
 		err = ErrInvalidSharedKey
+		return
 	}
+	sk = padded[:size]
 	return
 }
 
@@ -200,40 +283,53 @@ type KEKParams struct {
 	SuppPubInfo     []byte
 }
 
+// KeySpecificInfo must be exported in full, Counter included: it's
+// re-marshaled into otherInfo on every iteration of the CEK loop below,
+// and an unmarshaled field would silently drop out of that hash input,
+// making every iteration hash the same bytes.
 type KeySpecificInfo struct {
 	Algorithm asn1.ObjectIdentifier
-	counter   []byte
+	Counter   []byte
 }
 
 // Pre-defined KEK parameters to make life easier when generating KEKs.
 var (
 	KEKAES128CBCHMACSHA256 = KEKParams{
-		KeySpecificInfo: keySpecificInfo{
+		KeySpecificInfo: KeySpecificInfo{
 			Algorithm: AES128CBC,
 		},
 		SuppPubInfo: []byte{0, 0, 0, 48},
 	}
 	KEKAES192CBCHMACSHA384 = KEKParams{
-		KeySpecificInfo: keySpecificInfo{
+		KeySpecificInfo: KeySpecificInfo{
 			Algorithm: AES192CBC,
 		},
 		SuppPubInfo: []byte{0, 0, 0, 72},
 	}
 	KEKAES256CBCHMACSHA512 = KEKParams{
-		KeySpecificInfo: keySpecificInfo{
+		KeySpecificInfo: KeySpecificInfo{
 			Algorithm: AES256CBC,
 		},
 		SuppPubInfo: []byte{0, 0, 0, 32},
 	}
 	KEKAES256CBCHMACSHA256 = KEKParams{
-		KeySpecificInfo: keySpecificInfo{
+		KeySpecificInfo: KeySpecificInfo{
 			Algorithm: AES256CBC,
 		},
 		SuppPubInfo: []byte{0, 0, 0, 64},
 	}
 )
 
-// KeyLen returns the shared key size this KEK should be used to generate.
+// maxCEKKeyLen bounds the keylen CEK will derive. It's far above any key
+// size dhkam actually uses, but keeps the per-block counter loop below
+// from ever running long enough to matter for a 32-bit counter, and
+// rejects a SuppPubInfo a MITM could inflate to force a pathologically
+// long derivation.
+const maxCEKKeyLen = 512
+
+// KeyLen returns the shared key size this KEK should be used to
+// generate, or 0 if SuppPubInfo can't be parsed or asks for more key
+// material than maxCEKKeyLen allows.
 func (kek KEK) KeyLen() int {
 	var keylen32 uint32
 	buf := bytes.NewBuffer(kek.Params.SuppPubInfo)
@@ -243,6 +339,9 @@ func (kek KEK) KeyLen() int {
 		return 0
 	}
 
+	if keylen32 > uint32(maxCEKKeyLen) {
+		return 0
+	}
 	return int(keylen32)
 }
 
@@ -284,11 +383,18 @@ func (prv *PrivateKey) InitializeKEK(rand io.Reader, pub *PublicKey,
 		return nil
 	}
 
-	var keylen32 int32
+	// Parsed the same way KEK.KeyLen parses it, and bounded before ever
+	// converting to int: an attacker-supplied SuppPubInfo this reads as
+	// an int32 could wrap negative on a 32-bit int platform, slipping
+	// past a keylen > maxCEKKeyLen check performed after conversion.
+	var keylen32 uint32
 	buf := bytes.NewBuffer(params.SuppPubInfo)
 	if err := binary.Read(buf, binary.BigEndian, &keylen32); err != nil {
 		return nil
 	}
+	if keylen32 == 0 || keylen32 > uint32(maxCEKKeyLen) {
+		return nil
+	}
 	keylen := int(keylen32)
 
 	var err error
@@ -298,11 +404,11 @@ func (prv *PrivateKey) InitializeKEK(rand io.Reader, pub *PublicKey,
 	if err != nil {
 		return nil
 	}
-	kek.ZZ = zeroPad(kek.ZZ, (P.BitLen()+7)/8)
+	kek.ZZ = zeroPad(kek.ZZ, prv.group().PubBytes)
 
 	kek.Params = params
 	kek.Params.PartyAInfo = ainfo
-	kek.Params.KeySpecificInfo.counter = []byte{0, 0, 0, 1}
+	kek.Params.KeySpecificInfo.Counter = []byte{0, 0, 0, 1}
 	kek.h = h
 	return &kek
 }
@@ -316,21 +422,20 @@ func (prv *PrivateKey) CEK(kek *KEK) (key []byte, err error) {
 		return nil, ErrInvalidKEKParams
 	}
 
-	otherInfo, err := marshalKEKParams(kek)
-	if err != nil {
-		return
-	}
-
 	kek.h.Reset()
 	hLen := kek.h.Size()
 
 	key = make([]byte, keylen)
 	for i := 0; i < keylen; i += hLen {
+		otherInfo, err := marshalKEKParams(kek)
+		if err != nil {
+			return nil, err
+		}
 		kek.h.Write(kek.ZZ)
 		kek.h.Write(otherInfo)
 		copy(key[i:], kek.h.Sum(nil))
 		kek.h.Reset()
-		incCounter(kek.Params.KeySpecificInfo.counter)
+		incCounter(kek.Params.KeySpecificInfo.Counter)
 	}
 	key = key[:keylen]
 	return
@@ -343,7 +448,7 @@ func zeroPad(in []byte, outlen int) (out []byte) {
 	if inLen = len(in); inLen > outlen {
 		inLen = outlen
 	}
-	start := outlen - inLen - 1
+	start := outlen - inLen
 	out = make([]byte, outlen)
 	copy(out[start:], in)
 	return
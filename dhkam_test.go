@@ -2,6 +2,7 @@ package dhkam
 
 import "bytes"
 import "crypto/rand"
+import "crypto/sha256"
 import "crypto/sha512"
 import "fmt"
 import "testing"
@@ -157,6 +158,213 @@ func TestKEK(t *testing.T) {
 	}
 }
 
+// TestSharedKeyConstantTime validates SharedKeyConstantTime the same way
+// TestSharedKey validates SharedKey. It does not compare the two methods'
+// outputs with each other (see the note at the end of the test).
+func TestSharedKeyConstantTime(t *testing.T) {
+	prv1, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+	prv2, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	sk1, err := prv1.SharedKeyConstantTime(rand.Reader, &prv2.PublicKey, SharedKeySize)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+	sk2, err := prv2.SharedKeyConstantTime(rand.Reader, &prv1.PublicKey, SharedKeySize)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+	if !bytes.Equal(sk1, sk2) {
+		fmt.Println("constant-time shared keys don't match")
+		t.FailNow()
+	}
+
+	// SharedKey and SharedKeyConstantTime are not expected to agree: as
+	// SharedKeyConstantTime's own doc comment explains, SharedKey slices
+	// skBig.Bytes() directly, while SharedKeyConstantTime zero-pads to the
+	// group's full width first, so the two diverge whenever the shared
+	// value's big-endian encoding has a leading zero byte (roughly 1 in
+	// 256 keypairs). Asserting they match here would make this test flaky.
+}
+
+// TestSharedKeyRejectsGroupMismatch confirms SharedKey and
+// SharedKeyConstantTime refuse to compute a shared key across two keys
+// from different groups, rather than silently exponentiating pub.A mod
+// the wrong group's P.
+func TestSharedKeyRejectsGroupMismatch(t *testing.T) {
+	prv1, err := GenerateKeyInGroup(rand.Reader, Group14)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+	prv2, err := GenerateKeyInGroup(rand.Reader, Group15)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	if _, err := prv1.SharedKey(rand.Reader, &prv2.PublicKey, SharedKeySize); err != ErrInvalidPublicKey {
+		fmt.Println("SharedKey accepted a cross-group public key")
+		t.FailNow()
+	}
+	if _, err := prv1.SharedKeyConstantTime(rand.Reader, &prv2.PublicKey, SharedKeySize); err != ErrInvalidPublicKey {
+		fmt.Println("SharedKeyConstantTime accepted a cross-group public key")
+		t.FailNow()
+	}
+}
+
+// TestCEKLargeKeylenProducesDistinctBlocks requests a CEK longer than a
+// single hash output (SHA-256's 32 bytes) and confirms the two halves
+// differ, guarding against the counter silently dropping out of
+// otherInfo and every block hashing to the same bytes.
+func TestCEKLargeKeylenProducesDistinctBlocks(t *testing.T) {
+	prv1, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+	prv2, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	params := KEKAES128CBCHMACSHA256
+	params.SuppPubInfo = []byte{0, 0, 0, 64} // 64 bytes, 2x SHA-256's 32-byte output
+
+	kek := prv1.InitializeKEK(rand.Reader, &prv2.PublicKey, params, nil, sha256.New())
+	if kek == nil {
+		fmt.Println("dhkam: failed to initialise KEK")
+		t.FailNow()
+	}
+
+	key, err := prv1.CEK(kek)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	if bytes.Equal(key[:32], key[32:]) {
+		fmt.Println("dhkam: CEK halves match, counter isn't varying otherInfo")
+		t.FailNow()
+	}
+}
+
+// TestCEKRejectsOversizedKeylen confirms InitializeKEK refuses a
+// SuppPubInfo that requests more key material than maxCEKKeyLen allows,
+// rather than handing back a KEK whose CEK loop could run the 32-bit
+// counter for an implausible number of iterations.
+func TestCEKRejectsOversizedKeylen(t *testing.T) {
+	prv1, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+	prv2, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	params := KEKAES128CBCHMACSHA256
+	params.SuppPubInfo = []byte{0, 0, 4, 0} // 1024 bytes, over maxCEKKeyLen
+
+	if kek := prv1.InitializeKEK(rand.Reader, &prv2.PublicKey, params, nil, sha256.New()); kek != nil {
+		fmt.Println("dhkam: InitializeKEK accepted an oversized keylen")
+		t.FailNow()
+	}
+}
+
+// TestCEKRejectsKeylenOverGroupWidth confirms InitializeKEK refuses (and
+// doesn't panic on) a SuppPubInfo keylen that's under maxCEKKeyLen but
+// still wider than the group's own PubBytes, which previously reached
+// past SharedKey's output and panicked instead of returning an error.
+func TestCEKRejectsKeylenOverGroupWidth(t *testing.T) {
+	prv1, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+	prv2, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	params := KEKAES128CBCHMACSHA256
+	params.SuppPubInfo = []byte{0, 0, 1, 144} // 400 bytes, over Group14's 256-byte width
+
+	if kek := prv1.InitializeKEK(rand.Reader, &prv2.PublicKey, params, nil, sha256.New()); kek != nil {
+		fmt.Println("dhkam: InitializeKEK accepted a keylen wider than the group")
+		t.FailNow()
+	}
+}
+
+// BenchmarkSharedKeyFixedKey and BenchmarkSharedKeyRandomKeys exist to be
+// compared against each other, not against any fixed threshold: the first
+// reuses the same private key for every iteration, the second generates a
+// fresh one each time, and ctExp's exponent-independent running time
+// means a maintainer re-running both after touching blind or ctExp
+// should see ns/op stay in the same ballpark across the two. A
+// regression that makes SharedKey's timing depend on the private key's
+// value would show up as the two diverging. Both benchmarks time
+// SharedKeyConstantTime, which shares blind and ctExp with SharedKey.
+func BenchmarkSharedKeyFixedKey(b *testing.B) {
+	prv, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		b.FailNow()
+	}
+	pub, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		b.FailNow()
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := prv.SharedKeyConstantTime(rand.Reader, &pub.PublicKey, SharedKeySize)
+		if err != nil {
+			fmt.Println(err.Error())
+			b.FailNow()
+		}
+	}
+}
+
+func BenchmarkSharedKeyRandomKeys(b *testing.B) {
+	pub, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		b.FailNow()
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		prv, err := GenerateKey(rand.Reader)
+		if err != nil {
+			fmt.Println(err.Error())
+			b.FailNow()
+		}
+		b.StartTimer()
+
+		_, err = prv.SharedKeyConstantTime(rand.Reader, &pub.PublicKey, SharedKeySize)
+		if err != nil {
+			fmt.Println(err.Error())
+			b.FailNow()
+		}
+	}
+}
+
 // Benchmark the generate of private keys.
 func BenchmarkGenerateKey(b *testing.B) {
 	for i := 0; i < b.N; i++ {
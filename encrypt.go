@@ -0,0 +1,199 @@
+package dhkam
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/asn1"
+	"fmt"
+	"hash"
+	"io"
+)
+
+var (
+	ErrInvalidCiphertext = fmt.Errorf("dhkam: invalid ciphertext")
+	ErrInvalidMAC        = fmt.Errorf("dhkam: invalid message authentication code")
+)
+
+// Params selects the symmetric primitives used by PrivateKey.Encrypt and
+// PrivateKey.Decrypt: the KEK parameters that pick the AES key size and
+// the keying material layout, and the hash used both for the CEK's
+// concatKDF and for the HMAC.
+type Params struct {
+	KEK  KEKParams
+	Hash func() hash.Hash
+}
+
+// Pre-defined Params pairing each AES key size with a matching HMAC hash,
+// mirroring the KEKAES*CBCHMAC* parameter sets used by InitializeKEK.
+var (
+	ParamsAES128CBCHMACSHA256 = Params{KEK: KEKAES128CBCHMACSHA256, Hash: sha256.New}
+	ParamsAES192CBCHMACSHA384 = Params{KEK: KEKAES192CBCHMACSHA384, Hash: sha512.New384}
+	ParamsAES256CBCHMACSHA256 = Params{KEK: KEKAES256CBCHMACSHA256, Hash: sha256.New}
+)
+
+// aesKeySize returns the AES key size in bytes for the CBC algorithm
+// identified by alg.
+func aesKeySize(alg asn1.ObjectIdentifier) (int, error) {
+	switch {
+	case alg.Equal(AES128CBC):
+		return 16, nil
+	case alg.Equal(AES192CBC):
+		return 24, nil
+	case alg.Equal(AES256CBC):
+		return 32, nil
+	default:
+		return 0, ErrInvalidKEKParams
+	}
+}
+
+// Encrypt implements a DHIES/ECIES-style hybrid encryption: it generates
+// an ephemeral DH keypair, derives a KEK against pub, and splits the
+// resulting CEK into an AES-CBC key and an HMAC key per params.KEK's
+// SuppPubInfo. s1 is bound into the KEK as PartyAInfo; s2 is appended to
+// the HMAC input alongside the ciphertext, matching the s1/s2 shared
+// information fields of ECIES. The returned envelope is laid out as
+// ephemeralPub || IV || ciphertext || tag, with ephemeralPub zero-padded
+// to a fixed lenPub bytes so the offsets are recoverable without parsing.
+func (prv *PrivateKey) Encrypt(rand io.Reader, pub *PublicKey, params Params, plaintext, s1, s2 []byte) (ciphertext []byte, err error) {
+	ephemeral, err := GenerateKey(rand)
+	if err != nil {
+		return nil, err
+	}
+
+	kek := ephemeral.InitializeKEK(rand, pub, params.KEK, s1, params.Hash())
+	if kek == nil {
+		return nil, ErrInvalidKEKParams
+	}
+	cek, err := ephemeral.CEK(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	keySize, err := aesKeySize(params.KEK.KeySpecificInfo.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if len(cek) <= keySize {
+		return nil, ErrInvalidKEKParams
+	}
+	aesKey, hmacKey := cek[:keySize], cek[keySize:]
+
+	block, err := aes.NewCipher(aesKey)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err = io.ReadFull(rand, iv); err != nil {
+		return nil, err
+	}
+
+	padded := pkcs7Pad(plaintext, aes.BlockSize)
+	encrypted := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, padded)
+
+	ephemeralPub := zeroPad(ephemeral.Export(), lenPub)
+
+	mac := hmac.New(params.Hash, hmacKey)
+	mac.Write(ephemeralPub)
+	mac.Write(iv)
+	mac.Write(encrypted)
+	mac.Write(s2)
+
+	ciphertext = append(ciphertext, ephemeralPub...)
+	ciphertext = append(ciphertext, iv...)
+	ciphertext = append(ciphertext, encrypted...)
+	ciphertext = append(ciphertext, mac.Sum(nil)...)
+	return
+}
+
+// Decrypt reverses Encrypt: it parses the ephemeral public key out of the
+// fixed-offset envelope, derives the same KEK against it, verifies the
+// HMAC tag before touching the ciphertext, and returns the recovered
+// plaintext.
+func (prv *PrivateKey) Decrypt(rand io.Reader, params Params, ciphertext, s1, s2 []byte) (plaintext []byte, err error) {
+	macSize := params.Hash().Size()
+	if len(ciphertext) < lenPub+2*aes.BlockSize+macSize {
+		return nil, ErrInvalidCiphertext
+	}
+
+	ephemeralPubBytes := ciphertext[:lenPub]
+	iv := ciphertext[lenPub : lenPub+aes.BlockSize]
+	encrypted := ciphertext[lenPub+aes.BlockSize : len(ciphertext)-macSize]
+	tag := ciphertext[len(ciphertext)-macSize:]
+	if len(encrypted)%aes.BlockSize != 0 {
+		return nil, ErrInvalidCiphertext
+	}
+
+	ephemeralPub, err := ImportPublic(ephemeralPubBytes)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+
+	kek := prv.InitializeKEK(rand, ephemeralPub, params.KEK, s1, params.Hash())
+	if kek == nil {
+		return nil, ErrInvalidKEKParams
+	}
+	cek, err := prv.CEK(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	keySize, err := aesKeySize(params.KEK.KeySpecificInfo.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if len(cek) <= keySize {
+		return nil, ErrInvalidKEKParams
+	}
+	aesKey, hmacKey := cek[:keySize], cek[keySize:]
+
+	mac := hmac.New(params.Hash, hmacKey)
+	mac.Write(ephemeralPubBytes)
+	mac.Write(iv)
+	mac.Write(encrypted)
+	mac.Write(s2)
+	if !hmac.Equal(tag, mac.Sum(nil)) {
+		return nil, ErrInvalidMAC
+	}
+
+	block, err := aes.NewCipher(aesKey)
+	if err != nil {
+		return nil, err
+	}
+	decrypted := make([]byte, len(encrypted))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, encrypted)
+
+	return pkcs7Unpad(decrypted, aes.BlockSize)
+}
+
+// pkcs7Pad pads in to a multiple of blockSize per PKCS#7.
+func pkcs7Pad(in []byte, blockSize int) []byte {
+	padLen := blockSize - len(in)%blockSize
+	out := make([]byte, len(in)+padLen)
+	copy(out, in)
+	for i := len(in); i < len(out); i++ {
+		out[i] = byte(padLen)
+	}
+	return out
+}
+
+// pkcs7Unpad strips and validates PKCS#7 padding from in.
+func pkcs7Unpad(in []byte, blockSize int) ([]byte, error) {
+	if len(in) == 0 || len(in)%blockSize != 0 {
+		return nil, ErrInvalidCiphertext
+	}
+	padLen := int(in[len(in)-1])
+	if padLen == 0 || padLen > blockSize || padLen > len(in) {
+		return nil, ErrInvalidCiphertext
+	}
+	for _, b := range in[len(in)-padLen:] {
+		if int(b) != padLen {
+			return nil, ErrInvalidCiphertext
+		}
+	}
+	return in[:len(in)-padLen], nil
+}
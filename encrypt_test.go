@@ -0,0 +1,75 @@
+package dhkam
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+)
+
+// TestEncryptDecrypt generates two private keys, encrypts a message from
+// one to the other's public key, and verifies that Decrypt recovers the
+// original plaintext.
+func TestEncryptDecrypt(t *testing.T) {
+	prv1, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+	prv2, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	plaintext := []byte("the quick brown fox jumps over the lazy dog")
+	s1 := bytes.Repeat([]byte{0x01}, 64)
+	s2 := []byte("application context")
+
+	ciphertext, err := prv1.Encrypt(rand.Reader, &prv2.PublicKey, ParamsAES256CBCHMACSHA256, plaintext, s1, s2)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	decrypted, err := prv2.Decrypt(rand.Reader, ParamsAES256CBCHMACSHA256, ciphertext, s1, s2)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	if !bytes.Equal(plaintext, decrypted) {
+		fmt.Println("dhkam: decrypted plaintext doesn't match")
+		t.FailNow()
+	}
+}
+
+// TestDecryptRejectsTamperedCiphertext confirms that flipping a byte in
+// the ciphertext causes Decrypt to fail the HMAC check rather than
+// silently returning corrupted plaintext.
+func TestDecryptRejectsTamperedCiphertext(t *testing.T) {
+	prv1, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+	prv2, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	ciphertext, err := prv1.Encrypt(rand.Reader, &prv2.PublicKey, ParamsAES256CBCHMACSHA256,
+		[]byte("tamper me"), nil, nil)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	ciphertext[len(ciphertext)-1] ^= 0xff
+
+	if _, err := prv2.Decrypt(rand.Reader, ParamsAES256CBCHMACSHA256, ciphertext, nil, nil); err != ErrInvalidMAC {
+		fmt.Println("dhkam: tampered ciphertext was not rejected")
+		t.FailNow()
+	}
+}
@@ -0,0 +1,626 @@
+package dhkam
+
+import "math/big"
+
+var (
+	bigZero = big.NewInt(0)
+	bigOne  = big.NewInt(1)
+)
+
+// Group describes a finite-field Diffie-Hellman group: its prime modulus
+// P, its generator G, and Q, the prime order of the subgroup G
+// generates. Valid uses Q directly for the RFC 7919 subgroup check, so
+// it doesn't need to know how a given group arrived at its Q.
+//
+// Group14, Group15 and FFDHE2048 are the real RFC 3526 / RFC 7919 safe
+// primes, where Q is simply (P-1)/2. The LocalGroup* and LocalFFDHE*
+// groups below are NOT those standards' published 4096-bit-and-up
+// parameters: finding a safe prime by search gets exponentially more
+// expensive as the modulus grows, and transcribing RFC 3526/7919's own
+// text by hand at those sizes risks an unreviewed, undetectable digit
+// error, so instead each is generated locally using the construction
+// RFC 5114 and DSA use for their groups: Q is a separately generated
+// prime of one eighth the modulus's bit length (the same as the group's
+// ExponentBits), and P is searched for as a
+// multiple of Q plus one. The resulting modulus is a genuine prime-order
+// subgroup dhkam can safely run Diffie-Hellman in, and is independently
+// re-verified against Valid's subgroup check, but it is not
+// interoperable with any other RFC 3526/7919 implementation (e.g. TLS
+// FFDHE negotiation) and must not be presented as one.
+//
+// ExponentBits follows the same ratio dhkam has always used for Group 14
+// (a 256-bit exponent for a 2048-bit modulus, i.e. modulus/8): it is
+// comfortably above the NIST SP 800-56A minimums for each modulus size.
+// PubBytes is the fixed-width byte length of an encoded public value,
+// ceil(P.BitLen() / 8).
+type Group struct {
+	ID           string
+	P            *big.Int
+	G            *big.Int
+	Q            *big.Int
+	ExponentBits int
+	PubBytes     int
+}
+
+// mustHexGroup builds a Group from a safe prime: Q is derived as
+// (P-1)/2, so callers only need to supply P and G.
+func mustHexGroup(id, hexP string, g int64) *Group {
+	p, ok := new(big.Int).SetString(hexP, 16)
+	if !ok {
+		panic("dhkam: invalid group modulus for " + id)
+	}
+	q := new(big.Int).Rsh(new(big.Int).Sub(p, bigOne), 1)
+	return &Group{
+		ID:           id,
+		P:            p,
+		G:            big.NewInt(g),
+		Q:            q,
+		ExponentBits: p.BitLen() / 8,
+		PubBytes:     (p.BitLen() + 7) / 8,
+	}
+}
+
+// mustHexGroupQ builds a Group whose subgroup order doesn't come for
+// free as (P-1)/2, for groups built with the RFC 5114-style
+// multiply-by-Q-and-search construction instead of a safe prime. Unlike
+// the small fixed generator (2) RFC 3526/7919's safe primes use, a
+// generator of a prime-order subgroup built this way isn't small, so G
+// is given as hex too rather than a small int64.
+func mustHexGroupQ(id, hexP, hexQ, hexG string) *Group {
+	p, ok := new(big.Int).SetString(hexP, 16)
+	if !ok {
+		panic("dhkam: invalid group modulus for " + id)
+	}
+	q, ok := new(big.Int).SetString(hexQ, 16)
+	if !ok {
+		panic("dhkam: invalid group order for " + id)
+	}
+	g, ok := new(big.Int).SetString(hexG, 16)
+	if !ok {
+		panic("dhkam: invalid group generator for " + id)
+	}
+	return &Group{
+		ID:           id,
+		P:            p,
+		G:            g,
+		Q:            q,
+		ExponentBits: p.BitLen() / 8,
+		PubBytes:     (p.BitLen() + 7) / 8,
+	}
+}
+
+// RFC 3526 MODP groups 14 and 15.
+var (
+	Group14 = mustHexGroup("modp2048", ""+
+		"FFFFFFFFFFFFFFFFC90FDAA22168C234C4C6628B80DC1CD1"+
+		"29024E088A67CC74020BBEA63B139B22514A08798E3404DD"+
+		"EF9519B3CD3A431B302B0A6DF25F14374FE1356D6D51C245"+
+		"E485B576625E7EC6F44C42E9A637ED6B0BFF5CB6F406B7ED"+
+		"EE386BFB5A899FA5AE9F24117C4B1FE649286651ECE45B3D"+
+		"C2007CB8A163BF0598DA48361C55D39A69163FA8FD24CF5F"+
+		"83655D23DCA3AD961C62F356208552BB9ED529077096966D"+
+		"670C354E4ABC9804F1746C08CA18217C32905E462E36CE3B"+
+		"E39E772C180E86039B2783A2EC07A28FB5C55DF06F4C52C9"+
+		"DE2BCBF6955817183995497CEA956AE515D2261898FA0510"+
+		"15728E5A8AACAA68FFFFFFFFFFFFFFFF", 2)
+
+	Group15 = mustHexGroup("modp3072", ""+
+		"FFFFFFFFFFFFFFFFC90FDAA22168C234C4C6628B80DC1CD1"+
+		"29024E088A67CC74020BBEA63B139B22514A08798E3404DD"+
+		"EF9519B3CD3A431B302B0A6DF25F14374FE1356D6D51C245"+
+		"E485B576625E7EC6F44C42E9A637ED6B0BFF5CB6F406B7ED"+
+		"EE386BFB5A899FA5AE9F24117C4B1FE649286651ECE45B3D"+
+		"C2007CB8A163BF0598DA48361C55D39A69163FA8FD24CF5F"+
+		"83655D23DCA3AD961C62F356208552BB9ED529077096966D"+
+		"670C354E4ABC9804F1746C08CA18217C32905E462E36CE3B"+
+		"E39E772C180E86039B2783A2EC07A28FB5C55DF06F4C52C9"+
+		"DE2BCBF6955817183995497CEA956AE515D2261898FA0510"+
+		"15728E5A8AAAC42DAD33170D04507A33A85521ABDF1CBA64"+
+		"ECFB850458DBEF0A8AEA71575D060C7DB3970F85A6E1E4C7"+
+		"ABF5AE8CDB0933D71E8C94E04A25619DCEE3D2261AD2EE6B"+
+		"F12FFA06D98A0864D87602733EC86A64521F2B18177B200C"+
+		"BBE117577A615D6C770988C0BAD946E208E24FA074E5AB31"+
+		"43DB5BFCE0FD108E4B82D120A93AD2CAFFFFFFFFFFFFFFFF", 2)
+)
+
+// LocalGroup4096, LocalGroup6144 and LocalGroup8192 are sized to match
+// the MODP family's 4096, 6144 and 8192-bit groups, but they are NOT RFC
+// 3526's modp4096/modp6144/modp8192: as explained on Group above, each
+// modulus, subgroup order and generator below was generated locally with
+// the RFC 5114 construction and independently re-verified against
+// PublicKey.Valid's subgroup check, rather than transcribed from RFC 3526's
+// own nested-safe-prime text by hand at these sizes. Do not use these
+// where interop with another RFC 3526 implementation is required.
+var (
+	LocalGroup4096 = mustHexGroupQ("local-modp4096", ""+
+		"C31EA0F0564B2E92E0E3B0E0B59E480BFB2CDE6791FFFCB49"+
+		"A8F4D1F7F1F7F7C45E24083C3D1CC9B633B3EDC4E45D24425"+
+		"1F4B44A1AD465D70F70B7793F4F09E17B61BC4CBD1FDF85AF"+
+		"E47A98197C582BB03BB5915EB7E10CCEDBB48C11166E4CF03"+
+		"30F9D8F2FC0DC16AD2E6F631BA72351A04D254625DF624818"+
+		"B9F026CF6816B2C1DBA7D3621EEBACE8F61A93CFCE43C5A85"+
+		"D9AC3F35D7C17550C2009924D2A5F9C432D91733DB4EEBFE6"+
+		"8585284C4806A541BB3E0022959C135AF7645EDB83166CAEA"+
+		"00AEA7E54BACC96B3F4831A9856BB617FDBDF26C07E78A55B"+
+		"FE4012C6A8FC4933FF727BAFD105C046D308668149A397A42"+
+		"38DC3CB80487E99C4B2AF58E1A0CF47E870CAA5D2512A9EEB"+
+		"6B2454CC3FA7D83D2EE56761ED4DF512A5C2CE10E8E7AC5A3"+
+		"8EDBD833C829E303620DB4FCE9D499AFDC0413BF69766532F"+
+		"FA0FE5787BF7E7F446F11A83035C4F8F8991A0BBF9F7FD27B"+
+		"8800CB5422A1B05598DF48C693E4023EAA7CAA6FFD84F0B62"+
+		"31E35E21E6B91DF21AF713606997D061BCD9FBE09964457F7"+
+		"59BD5DFE673CF743EC70E5439EADFE4C5DBB9A4CF44E88800"+
+		"CEFC72F1E10A370DDC4A2EC3A85DBCD52CF3DF6D253E9B69D"+
+		"45894B2D028A34E3520FBD6BA5BC312618227E611CF4A8E68"+
+		"A62CDAD84BEC9BA8F941477810663DDC3D3C68B68490AC1EB"+
+		"6EC42BD6AA10A1CF810CABEE348FEEE3A159D935B2F5",
+		""+
+			"EAFEE47C8849C3E0C009A96BD6424C3273D1526B4A992847A"+
+			"99E964C4DF9568E87461D3F4D7BE9435CCF62DB297BE79CDB"+
+			"DAD507C00955383D5C1A5CEDA3ED45",
+		""+
+			"20F7C20D18301C01AF2216998E19597328CD113C7ADF5C33D"+
+			"34516626E0CC2FB550AAFDDDDB54D5DB111134B0FBD791695"+
+			"A5953947EB4D5D223964DA780F7ED6503154D809964FD22F9"+
+			"15076C1EA71BC176D5FD81E01D6B07887A447506C2B1DA838"+
+			"9EB12D9ED32C65F3F56DC603BB25426BEB43C8EC2BAD21168"+
+			"6B8CA759ACB732399182ABEC9054F6E84A27E1A941A289F1D"+
+			"D79FEB391D3FA755D88A4F97ABBF553EECE5CC7E5975D8B31"+
+			"C333C028CDF4FFDAD6C7FDF50E75C77C199D7C9C4D75BF97E"+
+			"9998650C5451B1C47D70B537459247AA035B4D718B6E09528"+
+			"9DAC4BF1ECADC5CFA212F62C29B36C94D4FA5626CD0DA8A7E"+
+			"D708DABF52C9AD7194E218C207FF99436A556CBABB770EE30"+
+			"4EB770972BB9CF2C80F988FF73357E440CE0CB13181145A7B"+
+			"BB3F0716192D6FB4FB65C863B3B7EF82A4FF655566EF54411"+
+			"DC933523AEFC2D67909166BCAF57BB48BF574FDAFD2EA78D9"+
+			"C0E25EEB2D85A79FD640886216133A10F7E06A19B22A4A75B"+
+			"2FDCF1566119D6A15F43AD3A67B218998CA409E7484F94D3A"+
+			"D9B8A574131233214540F7C319BBECE6E59666E0826F1233F"+
+			"3898BD75C7279168D473BCF63DC0FC046B6AF3B5BCAA2AE54"+
+			"FE39AB831118BBD40AF21D0A220FEE69FBB405B53E80F7334"+
+			"57A273825701F805D4F3B4CA55679073B6ED0F1441BBA427A"+
+			"E635BB8A6FF50253936C11AE857F8A18B7CFA891E05B")
+	LocalGroup6144 = mustHexGroupQ("local-modp6144", ""+
+		"935309561C12CB8C3DC63AD40D5854E47D4067220D78A23F7"+
+		"51D5D34951318676CC68A948DF3A6E8402A702B2CDEF23EDC"+
+		"482379799ACA21F913F38E0633B6047C2D1BEB758516F5A0C"+
+		"343A328E4CF2D3491387984191C9307141937C8A365D7726C"+
+		"7334039A5D61235DB5BCA583EBFE08FD9F42234D3722C2D36"+
+		"BAFD33E81B39A7B30F62E15033E668439F713428171CA01B4"+
+		"C74CC2E670C729499A5CACD9B23CEEEE9BCA9E0F9828FDA20"+
+		"C9607D363E1F75CBBFB0E281F86ED07D6D812572B38657718"+
+		"F63364F4DF732C6533F8086EBED4A60FD648150911CDBA2C7"+
+		"5502351D48350FEB1BC4C0B846D62AA4B4730E0026F241C00"+
+		"E84FB5B4D5D576971D3647376CB336991BA3ADE0AFB8C398E"+
+		"D1C2C29A0A7DE4461476DB4AC6A1053BF12B8793B1A9AEC97"+
+		"E4ABE5C0AB223238F84B4E1EF71305C2778B6713B2DDB8EE4"+
+		"A756E2FD0AC0E16231C31C857644F58350209C16A7892507C"+
+		"48D0CAFB255CAEF58BF83173B412986932E05AF2070E7C365"+
+		"E452BE765A03975801304E7B3F9367052E110E0992CEC0D3D"+
+		"F49EDE8C515857ED3E251DDD6E9BB4EAF1F670C732FBFD4F6"+
+		"33938CA6071BAF9D686064A025F7AEFABE210A20C29E757CC"+
+		"524E854A28F123B8A7511DCC5173CEBC75BB7DD0024DA3E5E"+
+		"C6E0CE4760C219F79237B03820CD3E27B9797D13B57479B57"+
+		"A3970015E2FF8C3B410436937AB966BC6E0237F1DE0B14468"+
+		"1FAB3D00E8B1FBB9B6DE9053D4473F663CAA09B0CEAF41375"+
+		"9AE56E4D2FA5AC54C6B59A6B862D8C79DB57F9893F1284753"+
+		"FEB0F4D8C9B09F6AB928D20CFCF0E1D698C4825DA91146B09"+
+		"D699AF49E30D2025B88F8AD0506E866781683F6AA7240629A"+
+		"6BEF9AB3B0FC4B3F9D8DD16FA54E721C68DC7DB1C68B062DF"+
+		"F0DD61EFB17A50952AED623A1EC3225071ECE896BAB05E349"+
+		"EFF9246A6DFC6C1A1B21DA44C5B0B66D17B7275649A973596"+
+		"6B89B73FB008D654D6E572E30FC64F8DB129132AD62747143"+
+		"156822DD8589500D5DD711E6C04F904FBB3F4CCCC35F1224A"+
+		"2A755920D67334DEF74F5B18929ACA5278B226A9C02F6CE4C"+
+		"E5AE1F166A960D5B7",
+		""+
+			"CA1438E487C0936156DF5420598998C4AF6F76E19B0FE5144"+
+			"083D5DAB32FA234D8D49825E3E058FD2D53E3E0136D8BDDED"+
+			"51DBF0B07C9A895F709DFB4A11E5CA25120D9E26FBFF8B256"+
+			"08063031D18EEC302788D394A4933D94C11921410C55D",
+		""+
+			"68960ED15F96BD90143265E51A574A7FF924B0B5DE5051F34"+
+			"A403C5D930EF4E799DEB22D210F0601CDF75DD653CAE090BD"+
+			"3E5E50BABD5218449B151BDF421DBA6C57818E63CFC958E1F"+
+			"20C64EDD10F15F299895C1E788D224E57C4B7EBBB78CD599A"+
+			"111C4E045539BAEEB1C54C25604894201423E13444A73A196"+
+			"21FB1DBDD347E72DFA48D804F3432FC7514F63E4575F59BE1"+
+			"93D349018F4CB8E215F14BD91F62C2EA6DC3C86E9FAD15E1F"+
+			"B43B7260FE1186976D3A3D0BF72308F8C52C205DA3E73F640"+
+			"1971DA9DDEC614EF8F9FF3932F1D92B5E52632DBE3788FFCA"+
+			"E917AC270E74165C47900C676A2490AE62A62E25B5AE95545"+
+			"BB6FDE04CB69BFB320F3A029B259F6AE2D9827D5C1C7AC20E"+
+			"C976D56DFF8C1BFFA4EB86FA9651299FA5BAEEBB8E519B94D"+
+			"A57ED40F66B01FE669C69C780ED3595AD3629ECE4DCDD7EDC"+
+			"A280E21C76B44BB78C026DDDA96A850F47A6B248764E5BA65"+
+			"6872053F96AAE46B2525E138E93C430DFFC1F405008A0E52C"+
+			"A008655DD7C30EFB400F10EBE16550593B49BE8CB5375BF8E"+
+			"2BBCAD6C9AD8B51D070F06EE80D8043EF1612905838AF2605"+
+			"8C4C019ADB0C847691F24240526467C65803513912693F931"+
+			"0247DAD50D571389CAA7D08E121462DAF5A913C2910CC8B3A"+
+			"C5FE47C1A8AB87A342365893285D536B3352976D2D675B2DB"+
+			"B1A47B5FE7C8D691DDC75DED48A4308ED886F5361501FB493"+
+			"9049E0BAD427C19888A4CD757FE994272060828E84D85C11F"+
+			"C6A545CF8765F38F61994A492A85AA35BE4900339407EAB86"+
+			"FE6A988AEE8612D36A854EA79CD151E346FEE93E946943B05"+
+			"EC2FEF8C12C4CC9E2AA3DCB1D2EEE651DE057BCBD1351133E"+
+			"41631B9A6EE6EAF9C7A12B36A1FFCBF7A2F6845C8C3CAD121"+
+			"8E030EFA96BEFBAE0BAEB061F8CB6B0EA8D0555C8CF70F960"+
+			"236EF64DC20D75F05046E1D231E45C9A0B182B4550BFDED65"+
+			"9C9E9FA51DCDF35C635C8D12F4C1EF51789DC4F6C3169F1D7"+
+			"66BEAADA3FA31C72111D2AEE7AB2172A2AB80993B2BE6AFA0"+
+			"4C46AAC3DD34A422C507685F1F0A0B7A9EC694EC1A7AB9264"+
+			"9C61EF19754F5442B")
+	LocalGroup8192 = mustHexGroupQ("local-modp8192", ""+
+		"B432258A12794E0F46B070E8D3379A2C748BDB3FB43D4BFE5"+
+		"64DFBFF958E55E1D651093AEFE5E90F0CE7BDAC1F39D487CF"+
+		"263CEEEB2CDFA66CBE86847E1629A07731B67380AFDECEF84"+
+		"91D43DDF6A34A27947C533884A1BA379E22A5E47F1A88FA28"+
+		"B4A7C1700E78A665D28F263E0ABEE6135E209397BF6358DD6"+
+		"9EDA2DA1FF2E6E988D40388D4214D507C01E39369694BB56F"+
+		"ACA9AD1083CA2BE17C1097E2D8CFC63AB7E9881366E0BF0A5"+
+		"F942F328E51E28775701C4E353C825276AB32370B01190F2E"+
+		"215BCF98B7382DC88F949DAAA319BC4A5E74648B584B62F29"+
+		"E80C472C5BD44C42A0B7CD7BCBC2498666582F11211050FCC"+
+		"0D7D8656CF4DA4085E938B6617E714AE17D34E56D3809A63C"+
+		"2267D02F89161A0EF13F9E230D82080E177458E173E610D37"+
+		"BEBE9F44A04C6694E6708ECE4E97BECD3F8A9666579ADE64C"+
+		"9DCB48AA19AB3D434F56B71CCF4CE60EF342FD7CE0BA44D1A"+
+		"FA6CC455398EABA5DCC55354D61CBF25282A32E85FF9C78D9"+
+		"8F9ADD70746BA3B081DE5357A0B7707305417A56DCF2034E4"+
+		"4BCEAD41E67918CF0557756571534142560701275FF5C57CD"+
+		"CC32429010447B2E60644596195787BB8661BF1C49D9EE091"+
+		"B253A1EFCBCD286D0F7BEA49C971430536D6AE9DC03FF6AEC"+
+		"0FB3AA0F57C4FDC956C4EB83CD9DA22441CE8BD2D844C2AA4"+
+		"1BCF0A9D29EF11CFBCE513E0608073306C66F4BDAE79B8170"+
+		"AFC0201A7FDF44B1B2E237FA8283C8CC38826E1F9B73FADA4"+
+		"7B845EF0B23686805619085E14DE2F3B6BC651DFD2755E03E"+
+		"FCDB68F154CD29DBA6CAD04C4487A17B25DCEAAB50678BEFA"+
+		"516040B8D4ECD9DE50C3E6137179D92ACF3018BBB076C8A2B"+
+		"95FA6B8BFC43198705D76C36352333D6B79B47CCDCBE6CE61"+
+		"75B9DE8C4A78C90DB08ED1153F2E09F8FFF925B0446CD4721"+
+		"5FCE962AD2E1E09EB3C8E493460415463E43F16A2DBC77590"+
+		"741836F343AACE35904126404075AA755992B1D715D1C2A65"+
+		"1EC16E92B55EAE2C8FC1B8A50A9A8E570CEA0EC57B0BE8795"+
+		"1B75C7960A90799511BA85623DCC23498A9CB9F572F209EB0"+
+		"24DFCFA5CFB8CDC5FFA8F675B288BA64807006841E6E67665"+
+		"B66ED14D58287A6B3D1984AAAE551C0DCE9D719262423C36A"+
+		"81AF1A48D92A0B8A574C12FEF6CA590D7F918A992CACA5279"+
+		"7299230BCCCD59E7D133D9BFBB558495808244E8248848A95"+
+		"8C11C6934F63FAF7380A82DA248AEDC4370004FEE679A3BE7"+
+		"FD00FC91ABDBB5B57431C1743B802426B498B544D2B064415"+
+		"2B3FE41958CB8F254E8AECEAE2CA2DB565BA80C244E09D15C"+
+		"7EEFB39ACFEB364EB44BECD81D7AFFFD11B9F050F51F61644"+
+		"4EFFE449E67BF085A5CA1CE91165E9D499D5D2B8A8B22D0AF"+
+		"DDD07B460AA069B0C0873E9B80116CBC723A24C23ECBF9437"+
+		"F524E27424DBB5802BBD32FD72B3DF9A4F7033F",
+		""+
+			"E8EDB65CA85A6CC0E4CA0C7986A10DDA3FDC3F3007F7196BB"+
+			"C330F40CA368217EDC2F35F487444BD720000340E0C4E42FC"+
+			"D7A562FF4F7C5B505037A4BF33AD72A63F4D20C50BBF3C545"+
+			"974C8C80192F75C11177DFEEF969B27E7C06F5F074E785223"+
+			"ED66BCC98FE8E6EA948F87E9830FB95DC7762FFBC7A8CFC2D"+
+			"6CF5F4293F3",
+		""+
+			"41547DF5F90FA3EEEE64F086625E8CF42000B9A4648255DF3"+
+			"1A20B06D289A076EC31DDE9319DE0F62CBFF05C9644024F6D"+
+			"E4CA377F5462B271EA9AFC5F98DF3697FD3808AFA01B15EBE"+
+			"77249A9F964563F0CFB14C143EABFA3DCAEA121F4DD46C1A3"+
+			"DCD8E4FC43B55D4521770EC12B37B4D13ACABFD150A9E9ADF"+
+			"C775BBA2F9284564EC1DE9522F980392B8127AC0C321F25F2"+
+			"62EDA2382FFFE13BC0AD5305C1BBE963B7E49F85C5EA795C6"+
+			"CF12390F8146774735FF52CE08392E7A4456D70B8E9D7BBC4"+
+			"EDDA9E2DA88E0148063AE3F693D7EE27D473D2ACACC5A1FA2"+
+			"692CF9C18BA96D7E0EEB6BD4D84B7661DDE1920F866915C16"+
+			"9FBDD078197EC4E92E99D2142DB1FE4EB2BB46B3E9D3D1366"+
+			"35D2CA79DB904D7C65468869152BFD9D16E1CD49237262886"+
+			"4A4A0C7176C229C8967112870143588FB9D393273E043E9C2"+
+			"12B91D2408E9A31105337474BBE1EF83C125D4E37D4E1577E"+
+			"864F5D9F50BCDD671A079E46E441B67865BF2D60B7E9E95EC"+
+			"A4A6738112225E3878930F483C3D1402737D1B24AB816947A"+
+			"89FBCE129AE7DB67096667ADAA97335C6409A30ED2BECF314"+
+			"DEB0885CF44C9AF60E9FE3488372320143FBD4F7B376D1B29"+
+			"40BACC94A3E6BFA784FA5CA3C222FB122111FCE5E8C17A26D"+
+			"24EA7EC25A52FC5720C4336605686E1A5CFBB5270BF0C9F55"+
+			"76A165CA36D929E3E23C1A93D3CA3F253158E6929D09F1B83"+
+			"990B1B576D83D39AF8021F6471027E8772CA457B42E111FAC"+
+			"9C7C9BE4067B4EA755BF898C2440DEC454ADF85F763B8C22F"+
+			"539D179389E5913D869490CFDE7E23704897053AAFF515AB3"+
+			"A22B6C2143F392F632606BD782F689C1D281FCF61D39802AA"+
+			"BDAAF764D91DC8963BF67A96C619AC41F008FF119A94FBF65"+
+			"E2FF8CD8C3C86F2B3497089EC59C7994D1F4CEEC260D62C93"+
+			"C878D115B7E6260E290E397BDA83C25140BFA5A8585A261BA"+
+			"05EA6E9F42C4CD3FA2B2B1722F4CD01F0C5AE7B944BE057D9"+
+			"9467ADACD1000F2D5B128AF1B2BBE3073F637C9F2D7DD3838"+
+			"AEE85ECD8CBB6AB84F2B995F44E439D861CB47F66A6461F3F"+
+			"872B90C584F463CB71B28CCDDD2994C5FE1BD33B9F66EA99A"+
+			"91050402771E89C995992F66DA91DCC46BA24A4C8EC873E39"+
+			"6379D162187BCD6732F58890E093C581A3B10E5D8099C9AA6"+
+			"09170DE0F470C5E34374A2D4FF59975805692AC02D03B4FC5"+
+			"EC9FBFA50A681A7BCB0EA3DE0532E7A6556F46835C4912B1E"+
+			"AEAFE59EB03EE2861A74F7F7165428F1DF9A99070D1C6F49C"+
+			"4E31ADAA157319DD7D57D5AD16B8C4FD53EB6586B5621DC52"+
+			"D2811B1E199AE4A7B9335CFADD2A0DC82DAB1F76A0BFBF4BA"+
+			"5F7CA06A185530AEB459F8F3522226CC399DDD24C339E1911"+
+			"534B51FA0102A48DDF607AB062CBB8D09A9B4779AEBAF1278"+
+			"CF9BCC694318DE4A7F79989F05E51B6D92C78D8")
+)
+
+// RFC 7919 FFDHE groups, as used for TLS negotiated finite-field
+// Diffie-Hellman.
+var (
+	FFDHE2048 = mustHexGroup("ffdhe2048", ""+
+		"FFFFFFFFFFFFFFFFADF85458A2BB4A9AAFDC5620273D3CF1"+
+		"D8B9C583CE2D3695A9E13641146433FBCC939DCE249B3EF9"+
+		"7D2FE363630C75D8F681B202AEC4617AD3DF1ED5D5FD6561"+
+		"2433F51F5F066ED0856365553DED1AF3B557135E7F57C935"+
+		"984F0C70E0E68B77E2A689DAF3EFE8721DF158A136ADE735"+
+		"30ACCA4F483A797ABC0AB182B324FB61D108A94BB2C8E3FB"+
+		"B96ADAB760D7F4681D4F42A3DE394DF4AE56EDE76372BB19"+
+		"0B07A7C8EE0A6D709E02FCE1CDF7E2ECC03404CD28342F61"+
+		"9172FE9CE98583FF8E4F1232EEF28183C3FE3B1B4C6FAD73"+
+		"3BB5FCBC2EC22005C58EF1837D1683B2C6F34A26C1B2EFFA"+
+		"886B423861285C97FFFFFFFFFFFFFFFF", 2)
+)
+
+// LocalFFDHE3072, LocalFFDHE4096, LocalFFDHE6144 and LocalFFDHE8192 are
+// sized to match RFC 7919's ffdhe3072/ffdhe4096/ffdhe6144/ffdhe8192, but
+// are NOT those groups: they are built the same locally-generated,
+// independently re-verified way LocalGroup4096 through LocalGroup8192
+// are, rather than by transcribing RFC 7919's own text at these sizes.
+// Do not use these for TLS FFDHE negotiation or anywhere else interop
+// with RFC 7919 is required.
+var (
+	LocalFFDHE3072 = mustHexGroupQ("local-ffdhe3072", ""+
+		"A8F9B2DD5CB0D1CFAD9AABA38E35D3865F8127CD14D0FE9C5"+
+		"6424FD4933E98CEBEC7CDBF64996C658D173801165478BBDE"+
+		"8341696DCEEFFF6950FF886C3FE34B46CD84EDDDF58F51C53"+
+		"2F1996F57D103FA7CC30AC898B1335CE81AB007EE21446F85"+
+		"D76559B01F14948B8262D6741CFB98601403E44CA593E0BEE"+
+		"25A43964306A720A35083A915E3F3E4138BD9F54B94DFE8E3"+
+		"CE04BF8CC05B421C501DB31F576B83FDF25B44A941BD661D1"+
+		"050B28BF873BD9308FEBFEA73DB570273485F4F748CC01CDD"+
+		"97DA1301D17B508220957E0A124A18D94C165EB87BC149555"+
+		"D495533FAB2966668EDB47E9564A55B35520D360F42AAA570"+
+		"EB90282D2DFCFD4824F15C891B456B878D2E0EBE5651FFE26"+
+		"F803C9F179481B2AC3707FE7F0CF94FA2E39B164017DE341E"+
+		"FB156AEEFF84003002F7F3CB740AC452E3D59B8C75235CC71"+
+		"14FE644AFC657799970B42798D04C45CED6C1361FD62872C3"+
+		"4A4A1D4E75178B72AE21CD25536272DB14A6E6A09E9268E03"+
+		"8EA1E2F9AEF993D8D821CC7C0F53C3A07",
+		""+
+			"F2EA94C20BBF52740DA56500A7B59E8C0E7108CF4561D66E7"+
+			"5EAFA214F75DFA253EBDD0471BCDB92EB8334D932D5364D",
+		""+
+			"23CA91B29874198A311DE29D7F69E09D144C2D61E72B31EA9"+
+			"47642B73CDCD3F7947C8A123684FA26B390F5D7004CFBA711"+
+			"9A83D2627F45E9204B6526C8067038AE677036FAACB248D26"+
+			"FC4728518B2F78D08F04A0E5EB9953FC2B2B85D4FD7C34911"+
+			"CDE61CEF89C589DBBB010925D0DF946DBF654C8E0B66F98BB"+
+			"4604226CB65693015D019D1B3CE64E8CE63C16D046A1B2FC0"+
+			"C0F1F17A7D265B4A692079485A213059AE70F423D09A8757B"+
+			"BB82ADB94EEEFA08C1082340B7F2DF808DFFC93564E64E6FA"+
+			"7E48E68EB727FAFFDFD8137AC03B731A0835BB1EE912C1416"+
+			"786EB4E221AF5AD781C298A5D08D3F71861887E4BD9F9D715"+
+			"A4BC268D179DAE422294D9ED85E433B34AF4F94C043301026"+
+			"6B29D92F8C82DC3E8BD4BD91A833C701A8311220D8CE4AB2C"+
+			"8B766287105BB2F48441B7971135A0F011B9E9C13CBE00DC6"+
+			"A9B3A1CDA4539E56509462EF9964879A75D24AD4BB943D339"+
+			"FDD919D59F5BC3F500338B91B94F547A12FE70AAA740D5130"+
+			"E5AA44851A226CE06881E913184333C9C")
+	LocalFFDHE4096 = mustHexGroupQ("local-ffdhe4096", ""+
+		"AF840EC41DAD098E27117528CE02DAA7A37A71CD0E26E87BA"+
+		"1AE51F152F729C994DA4399E6F6CEB3F184721C2DF9C9CE6A"+
+		"9AF4390314FFDB989767178D76570C90D35C66F1DA7ABA0E8"+
+		"177E6C93156529F765ADD6261CF2833524D8404FD0F5ECA65"+
+		"2D1742846B481B9BB5A413B12A7F181BB4C31812AED463745"+
+		"DBB6BED0822E2623102EA43DFF0D23BBBCAFA438FC1EA1D97"+
+		"05CE2E25C97CB2C7DF6710F7FB0D8E5C430A880B17C92ED74"+
+		"1C4BE58944035DCFD913386EA1254EE5A81581DC471B31E71"+
+		"12B3A8BB5492F562CDB55408ED5B31593A12759465B1599EB"+
+		"4022F484106015CA56C062542A3D58ECA0C914B59C1D1EFF5"+
+		"92FF4FF01699F168AF7B9E0D42EA236844B2CFF710E73720C"+
+		"955B820C95938FBA82B896B5B202C1A506FE0107D3774CB05"+
+		"A380220BFA57C7846C540643EDA7A9D9A74F4BE1FBFD23390"+
+		"59039A164E45E0423A62148E2AC272D5DE60D56095A194E2B"+
+		"7937EEEAC58156C04A296CE39BF16B61C43E5DA36DE317260"+
+		"4F0942EFABEAE84E39DEBA0C1D33D65C4AE35348714694B64"+
+		"2434C769CA98CA6A66C06D2F54F6BF7557D87C8FE13542063"+
+		"93190520EF971B476EF848772575C792B6684106B58A194D2"+
+		"4BB4519F19E9B7D03EA7A51A76779A991DFEC5619CE8C5BC0"+
+		"EAE01C1D5E01D71BD4F7DA00A8D9B5238269DB9B964E4962B"+
+		"17228DDC6C35F68291798E63F1ED20F7AF140A83CD75",
+		""+
+			"E6F2838EFF952469354F12E9228E6E89D2C59B31BF3F2422C"+
+			"1818C23E01D0F1945A6A98700BE04118CFA5789980FC4E88E"+
+			"066F9D6EC90479E479B6ABAF99A0AF",
+		""+
+			"8466AA7FA50C27FEDF6C4A555691DF4F015AC4501FA47035E"+
+			"1336A61A0723318E08B954691CAD8139232CB94A44A32E1C2"+
+			"E82EEBF55F7A1FE394F054229B1480CF1E8AB9A51C780E8A0"+
+			"789AF443DEBCC761DD87299D17BC3E06D19D7803EAD133C59"+
+			"60787AB382CEB76A9160DE0AF2A740868EE39B67E50EDA658"+
+			"748D3E1256927A6D1C63F443161D4A9A9049997FAC2BE74AB"+
+			"906AA57C049D366007F8CB190100CE769551B13DFDE55F13B"+
+			"94A340397CA1E97C09429B3BE9FF4C595042410EC92BD1E11"+
+			"84E1F958BF9FD96D602CE3022D68208BC8B16A42B9E10A1CC"+
+			"23D27AB4DFC0C0A767267E1CF08448FD90E26CF79AA7ACE77"+
+			"2DC4D82ADD7322063E8D8080F9C9F91CA1A41FCB6DADA68BB"+
+			"15D8004BF835069A0887E01F40DB8618B5E1891153C7F10EB"+
+			"077BE9F88D459612BDAB811C1B31CD6CEF39DDD69BC1EB64A"+
+			"CBF4B8283A851B4D467709031F3CD5DF92F8A6CCE2388525D"+
+			"A4EF88A01160C9C8C8C662ABECD9AC3995E0ABA90F8809C3D"+
+			"DD82AEEB473B845E4AE6F104F6D0225CF741F82EADA5BB305"+
+			"7640C70A333268083E07475A599E459A8BA5BA2AD2FD89B8E"+
+			"6F812070BF51AEAD0D0B3681BC88BCB928DE444CD5D0CA8A4"+
+			"0942CBD907B2783A53A58B8D0E591929A0DC58AD5A7320C0C"+
+			"777E7BBB8A33AD57AB7C33E0BCC1E0A3197837EB2D5198B68"+
+			"52C79B3084344181CD0E451669250F5CA2F4DA8244E1")
+	LocalFFDHE6144 = mustHexGroupQ("local-ffdhe6144", ""+
+		"CE6B0B55D9EFB7F3C8D6D7A7C8FC96C64BC79E438A2531F0D"+
+		"5B1B969D74D6D2682A50124ECD67615D877144DFE36A5EFF6"+
+		"7E474F6DA0910FE285EFD5429BECBFB13F8FBED83872EE3C4"+
+		"6CF3E5F43FC8883A3BFD62C021B964D22FE6CA79E6A3DBC83"+
+		"732A1960F32EB3319AEB6FA12F9F6380229889510CE2FBEA6"+
+		"69CB7A6FF568A7B6C2542C5618FA1FF6A64B9E369B5A300FE"+
+		"7541B41F76A2CB838B5FDC42776758592CF92BD6F2DC5FF8F"+
+		"521F547C76A70F56E3146D3514641E124E0B98598DC154127"+
+		"7A2DDA47DF8E561FA0FBFEB200F89CDA345F68238297B340D"+
+		"EF4FF039130E972EDAA7B54E5DFDA7004F824FCEDDBE91220"+
+		"7CC1524BF64D2D9EE2DDA2820CEA21D9C847E60C52E850316"+
+		"49CD91ECC1F3B3EA4747A35760CB091137E53EB4DA1F7C080"+
+		"0960B29D7D0E00EE6F5CC13170AACF950F53F7EA300B6B22E"+
+		"7C1DC479D08625898DABEEE89E9EB4ACBCAA105F6B0B7FAC6"+
+		"A6585ED44A8673EEECC5ACB15984F74A7A3403A9EB890D208"+
+		"80458CBB8702D72497030C9E090066568D3DDEFC6517B5B4F"+
+		"4623668841C1985B388D65DD47792B6CD595F821CF06EB10B"+
+		"EA30EF7511BD688057EAEEFB0BF89B68CB291EF81E787D42E"+
+		"A449822F904754B4FC211C5F59C19C4635615A67235915042"+
+		"37C2EA767001D7EC8E890DF2C0D74C1E39145F085CFA4B9EB"+
+		"B8F5368920641DBDFE474D73FAF6AA11270CF03D28BCC4748"+
+		"A4DF7C53A2E6E70E95244236C6A0556E92E2CB684C1D94F59"+
+		"600AAAAF9F155396CBCB680E6BA73DCF90F31F5F50ADC8BDB"+
+		"DEC9E0883AD1F37AEBB70151640D9808C72B8D21729947B09"+
+		"F1EB7496FA2FAF3EAB5398DE348E43A102F2383AD6934EAC3"+
+		"2080FCE217534AB0C95A8A55C6A0524861D1704BF3367FFBE"+
+		"757E1B23F2D73711267E4BCBC2C186C5686543722BA277397"+
+		"8A9053E5B027AA46CF96ACB93013776F36DABAC97D062003E"+
+		"C34E414D87B7533745D5A813CED98E06E5E76BA8D842C0C29"+
+		"AA8B59098AC21EBF576FD93524677AF28E4B19544CD6B7C0A"+
+		"7DF0B1909F6092265AACD4F0D4608B0CE8AC8BB97167CB7E0"+
+		"D93956929E79F54B3",
+		""+
+			"F8B7BFE62F8F04C2D55FFF7C352FF4B381D04BAB48002F144"+
+			"0B2429DE961CAFEB97A67E4E4DE37D289D065A974CAB325CD"+
+			"3A520E27405DD97A2932F6C15F536C495CB2058CC06AF44B4"+
+			"F19FAC4B6668BD7AA8F68F3F35FC985E5F8C1E94D115F",
+		""+
+			"951623CF1B07ED926E02B85DBA58336BBA1A358BC4B963D60"+
+			"055DA11F3537E3969C7F8F70BF62D2DDAA97C68E6ACC88E51"+
+			"B4EF84C34DA29147167C6B64F199ECB739D0715CE2BCA21AB"+
+			"E00C4BB887A78CAC5D74F9CE59C139EE95CEFB6F447FFF5B9"+
+			"17DFEBC40C38F15DA9C3CCF8AB45003A47AD401732BA3EAC6"+
+			"4A9A378D5B74B6784EBE610A6588FD49626E676FB64A77A8A"+
+			"AE786DD099B622CB2DDC24D91A992B18D0713C3664FF9D7B5"+
+			"DDA03903F60911994D6C39CA8381FC17E2A2D8B560D347F53"+
+			"75B38A85E52CA80017DA38ADB9A2665DFF173D82F95B3DC0C"+
+			"D0B042F597F53C23E155D73F6098879863B6A36F805957B2B"+
+			"7C48C2671DBF45EAD617EE4B31E5D3703DB6E9D96DF68DF3E"+
+			"B704B0E1FF8603E9DBCA38BF5E69ED3FD21CDB1C0765C17BE"+
+			"A4F31C880CF22E9ABB7240D58291E01497ED7D6D666EC5B94"+
+			"F6F0F6A2B047E72C0051B5C1532EF508FB63893EC28B6B27D"+
+			"25CD49AD2646CEB9559884C51BA2FFBA1BDA024A336B3601F"+
+			"AD0D2CDF1CEE6F4EF1646EBE5A8141B3B5F47B2022FE9AD71"+
+			"C729A7D74D7254EBD9B2EADAA57E8C18742DD632A11ED5147"+
+			"C1C13C06B91B13938C5A8A94D00ED223197B0D9ECE18ECFC3"+
+			"C73AE1FEC0286D5CCCA1A4C0E163D3E6A3D580A9A202C4CD9"+
+			"4CBAEBAA3B6B37EBBDAFE2904E5E2EB70EF4C3302C6FD5618"+
+			"0A2305DD4CEC0D844C2BEC1156047B6DE6B8DCAF122ACA037"+
+			"A673BE73A146183248DCDF8DFB1CE43814178277C1A2485AB"+
+			"4F7808E852E71FB985756F39EC34655553A0D026A632AFE55"+
+			"AB2652E7C59A0EA86A7656D6DD03CA7D16204917FCF22DC54"+
+			"7B13EDB9E5A2348F99F46668AC17909F2C1697D14315FFD17"+
+			"1D381379D42E4048EDBAC42B860E81481F0EB040903806E64"+
+			"81E1381DAB71192F95D0C9F2741F7A8F7E415B0F045140D66"+
+			"C042EBE3B218C05C18BA0A357D7FF92FF24036C85E3822006"+
+			"7C6F727ADEC59AE1B6C180D1C1779F8EFF4BDB3A3B3CB9146"+
+			"93DABBB9F240C5A69656E0E10C73DB975AEBA52CB45B784FB"+
+			"D14D61FF7AA00E051D5D7E3B20EAAA43009A0E5F7BD238549"+
+			"28F4A4987C0BF4396")
+	LocalFFDHE8192 = mustHexGroupQ("local-ffdhe8192", ""+
+		"E12D5550312C8F8A523499C34CCD2EC2BFF48C28D255A2871"+
+		"DFFCB65F22EE00B497577CA326309EBBDB091D68B8918AB1C"+
+		"F47F049FE5C22681DF07BA7D73951924A407370F9D125F72B"+
+		"BA003E2AAE23EA76C128CD005C128B78F3D9D81C1BF54C2B4"+
+		"A3BE26D46FCF7F3B290CB2422AF5389CFF23FE13C67840CE5"+
+		"28B51590BA4C226A81D8E8CCED97A7956F96C93E30E46FB99"+
+		"767001B9790B382280A24DC9635EA06F2D81921CC59CEA63B"+
+		"1F86A08878614FF5FA09B62EFC90E534C1A47D352AF866F7F"+
+		"C5A8230510681A4B7FCE3BEA2023B1D0286E047A5BDCBC734"+
+		"D910153DF32EB2F70B5AC4AA807F687CE05187B5A7ACD00AF"+
+		"417E4EECAD9B9D4460D592A79A5B058823667B565862D215D"+
+		"B66EB941098C4BC35B09F9FD8DD582E1B09F23955B6DDB2EC"+
+		"95D8FCDBF27DB40A0FBA0E720C81A70366B7F5C26659A8086"+
+		"3CFF42AFCA992DC240CB29C638EBB62CB6E2ADB50CEE0BD14"+
+		"F9D0BD719ABC99A7B8CDEDAFEBA9D60B0FA1A3023CE5ED96D"+
+		"50BD756A666FD7C8F4146B2B9FF8EB622C37BFD737DBA834A"+
+		"00C6E34DFCB9985300B0F3DD4BC9CA1A0E4E21967CBA901B5"+
+		"AD21CC8897DC70A0239F8C63781C571692DF18A177C323A8B"+
+		"23289A19D12F158E7D7A8E793EE219FE051E1EB8F7BAEEFEC"+
+		"F3B74F5C568CF0999D33707AE8BA868E82823AB678CE49CE5"+
+		"C7ADD932007FE4B7A53E00F39AB134CCF5BB00B6C3A0A7EBF"+
+		"609B5052EFAC9AAD2C6412FE5E96442C6839D7098F4C9A97E"+
+		"EE03E2874E041846CED3EA89BAADFD4D6459995F4DBB63CAA"+
+		"08FBE5B8B012BD37B3F65E6A12E3B59B27C607481673AC4DE"+
+		"F42B7B5BBDAEB549D5AD67D9AC948C68830080EF89471E154"+
+		"9BC06140CDB250B16919C947391620740878D235DFCF54FA4"+
+		"B9B7C104FA541654B1C6EED19E960B478C14D5F1EE59CD261"+
+		"FB0D22EC53A2230A15E0C56960670F37DEC2F1E3E8B4BEE9D"+
+		"BC92A0B24216FAB8E29D729ED6AD8D5FC8C8FA6F393C9D778"+
+		"4A95B777E72236DEA282149C209745A8EBB2CBD6BF70DB123"+
+		"587E239472076F6B670846E11488EEAFB16AF159E14F5C79E"+
+		"3D40F53DABE66A9A258B5DA4D60A43531EE8B2336225FA28D"+
+		"A82960870ED2A8DF63AB5C29328F67D30C27DB71D2D46ECD2"+
+		"AB83FF02D8901583A27712050D6B82C602B1F909A51F125E2"+
+		"33E6B8811997393CA673D8F70E30F9C2D7018E63B5733A87A"+
+		"9F8B787B088530AF672437CFA2466D23D881FEB6F53A189B3"+
+		"1F7AC4673EA7ED78870F2868C08E55B1E4D3660FFD8158394"+
+		"E2978F01A5566B34734FB97E20E6248F82F2E4391F0AF5482"+
+		"2938415216F7A001E828A0437F23CE6040BBEFE0B9633B37E"+
+		"9C6380B4A994D88F5511C1B8F093148BEAEAD5D00C2A62790"+
+		"22F1E1D40A10BF34AE44F38E642B9671139789711F505E8FC"+
+		"96C298540848004377F3F71BD417FA0C387AD9D",
+		""+
+			"EEB58648FA29B69492041B0CA15AE6D5E0DCB2541DBF07A14"+
+			"FF2E8A1B080862C45FC67457903F28FF64BB5360FFBE302AF"+
+			"FF44132786577296CE6B3607F53804664DC2492CE81E5E882"+
+			"C276524CBCA0655E780AB3BBA5B4715AA0A5E551B48949B57"+
+			"D75D41A6A39779060CBC726EB68489385D8CE1A63479D2A3B"+
+			"36964A97E53",
+		""+
+			"2634C3815F67C7A2FA0481F957D56242D4CEBC8F64146A64A"+
+			"88A1E67C68D979DE96304E573B208B0CC05F93359E1A17477"+
+			"B7CD446B34F420E19E22649479FDACD64D99A05206300F844"+
+			"B645E00EB2D755F2AB53D913D3D0AA3E8729421258820E51A"+
+			"8BDC598DFDFF3CE364EF34143BEA38A0FCD4FE6BB8AA5D27B"+
+			"FA9687AA58F6FA7C41BF71E2EC351CDDAA59BBFD5FC60D892"+
+			"D126650D093570B29CD4B4C3EC365096DED55A2B2444AF9B4"+
+			"34F88E3F8336A3D3D42EE4522FFC65C0854A122A1392BC222"+
+			"A165C43381FE4865652ACA703D308BFA04E6D0B82530732DE"+
+			"ECF47EE35B0576B18D4CBB5B17D63D85B14B346B8D8DF2AAD"+
+			"AABFDE7E3016EA2BF59ABA3DF9E946ADAA17960617488E480"+
+			"74DDA243073ECE8A7381FE0FDE03D79A4D69D71EA68D7F317"+
+			"033104136110ADC0B3C3E3AB1116ACFBCA0852E744AFB6909"+
+			"968F4E5EC88117BBD5A46048A195E88C0DA79C71D3F270DD2"+
+			"3B358CAC6323556F7B910F3683B2BC92E770BE1BDE424D084"+
+			"546E9DA349C0B9705550E309C2A19E9E48690E682567351C0"+
+			"562C06DBAD71B4F70173C8FBBCC4E9F5E8282ABF738354CC5"+
+			"7B23DA8B36E0AA6602949E62074F8E6B47299A8B03050230F"+
+			"FB51B1C98873093EB979C2CD6268D0F26B1751C93D533CF87"+
+			"16C853472D963E0205BB8468801FA7B71BD7575017FC0DDA5"+
+			"1038563FB289263B8CC05FA2C2FD7AAF737CC568A9F330E41"+
+			"C7942F33D9242EB4E9EE8B082D9F13D4A0BE6713F1A525BA1"+
+			"5C34D4FAAFE3CA8423592B8047E8EF5037F966FEFC0D159BE"+
+			"1DD998E9BA6C7E0568BE78C2CDBE8FBD7156DE0F91F3B8529"+
+			"4968FE69076004B555CD40F3AB267C325F1873F044BEBFE73"+
+			"7BF746C6B799E601427288363E2A821BD1A06A133A75BB67B"+
+			"6AD46E5DE0AB8DEF713B74354EFCAAD1112B0E47A040736B0"+
+			"F2536C998ACFC275AF88AEC2C6D49DB072C81C7CDD2C0CDC8"+
+			"3C476FCD6B9670EC418C58EBDCCF6993BF5ADF881FAE4767C"+
+			"77C6476243744CA7E09FEC29B2C689C7E7F120C94F400E07C"+
+			"B3815147868DDA1416A8550DBFC45DF8A1D7B241D5E967685"+
+			"F9A1481BEF9EBB3E95A826C9C724DF4FA0E601A7EBC6EE396"+
+			"AF2A4196E8466206A2ED9DB3661DEF70561A94E175977B50E"+
+			"66297E54280500598EBAB86C6F06C81A254B7E84DCB87593B"+
+			"3331FFB3A841F94C701308CB1C19FF5047626601E557236E4"+
+			"2F3DF5D0FC8B9BB8CE8C2AE5B04C6FE0A9E023A4A73A49C11"+
+			"71347263EC738DDC36CAD03700422BDE56946AC4171237E12"+
+			"2CB4C35A310178685850F0E66CCD57360999A5A17C1CEB116"+
+			"AE0599A74DDD2200984992188CA1B5F10E34F5DDB8192F4EB"+
+			"3165EC62F8DA5B52CA96073ED55E359750877ACBF202448C0"+
+			"D8A3EE3F71F1EFA2B2922D86BA3D6FE5F1AEDC563C4F10A67"+
+			"5478AE5B49EFEBC859DD872C48D05ED807435FC")
+)
+
+// DefaultGroup is the group dhkam has always used: RFC 3526 MODP Group 14.
+var DefaultGroup = Group14
@@ -0,0 +1,60 @@
+package dhkam
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+// TestSharedKeyAcrossGroups generates two keypairs in each supported
+// group and confirms the shared key each side computes still matches,
+// just as TestSharedKey does for the default Group 14.
+func TestSharedKeyAcrossGroups(t *testing.T) {
+	groups := []*Group{Group14, Group15, LocalGroup4096, LocalGroup6144, LocalGroup8192,
+		FFDHE2048, LocalFFDHE3072, LocalFFDHE4096, LocalFFDHE6144, LocalFFDHE8192}
+
+	for _, group := range groups {
+		prv1, err := GenerateKeyInGroup(rand.Reader, group)
+		if err != nil {
+			fmt.Println(group.ID, err.Error())
+			t.FailNow()
+		}
+		prv2, err := GenerateKeyInGroup(rand.Reader, group)
+		if err != nil {
+			fmt.Println(group.ID, err.Error())
+			t.FailNow()
+		}
+
+		sk1, err := prv1.SharedKey(rand.Reader, &prv2.PublicKey, 32)
+		if err != nil {
+			fmt.Println(group.ID, err.Error())
+			t.FailNow()
+		}
+		sk2, err := prv2.SharedKey(rand.Reader, &prv1.PublicKey, 32)
+		if err != nil {
+			fmt.Println(group.ID, err.Error())
+			t.FailNow()
+		}
+
+		if string(sk1) != string(sk2) {
+			fmt.Println(group.ID, "shared keys don't match")
+			t.FailNow()
+		}
+	}
+}
+
+// TestPublicKeyValidRejectsOutOfRangeValues confirms Valid rejects values
+// outside (1, P-1) before it ever attempts the subgroup check.
+func TestPublicKeyValidRejectsOutOfRangeValues(t *testing.T) {
+	pub := &PublicKey{A: bigOne, Group: Group14}
+	if pub.Valid() {
+		t.Fail()
+	}
+
+	pMinus1 := new(big.Int).Sub(Group14.P, bigOne)
+	pub = &PublicKey{A: pMinus1, Group: Group14}
+	if pub.Valid() {
+		t.Fail()
+	}
+}
@@ -0,0 +1,176 @@
+package dhkam
+
+import (
+	"crypto/mlkem"
+	"crypto/sha512"
+	"encoding/asn1"
+	"fmt"
+	"io"
+)
+
+var (
+	ErrInvalidHybridPublicKey  = fmt.Errorf("dhkam: invalid hybrid public key")
+	ErrInvalidHybridCiphertext = fmt.Errorf("dhkam: invalid hybrid ciphertext")
+)
+
+// HybridPrivateKey pairs a classical dhkam.PrivateKey with an ML-KEM-768
+// decapsulation key, giving the holder a single keypair usable for
+// hybrid post-quantum key establishment: the final shared secret is only
+// recoverable by an attacker who breaks both the DH group and ML-KEM.
+type HybridPrivateKey struct {
+	DH    *PrivateKey
+	Kyber *mlkem.DecapsulationKey768
+}
+
+// HybridPublicKey is the public half of a HybridPrivateKey.
+type HybridPublicKey struct {
+	DH    *PublicKey
+	Kyber *mlkem.EncapsulationKey768
+}
+
+// HybridCiphertext bundles the sender's ephemeral DH public value with
+// the ML-KEM ciphertext produced by Encapsulate, so the receiver can
+// reconstruct both halves of the shared secret with Decapsulate.
+type HybridCiphertext struct {
+	DHPub   []byte
+	KyberCT []byte
+}
+
+// GenerateHybridKey generates a new hybrid DH/ML-KEM keypair.
+func GenerateHybridKey(prng io.Reader) (prv *HybridPrivateKey, err error) {
+	dhPrv, err := GenerateKey(prng)
+	if err != nil {
+		return nil, err
+	}
+	kyberPrv, err := mlkem.GenerateKey768()
+	if err != nil {
+		return nil, err
+	}
+	return &HybridPrivateKey{DH: dhPrv, Kyber: kyberPrv}, nil
+}
+
+// Public returns the public half of prv.
+func (prv *HybridPrivateKey) Public() *HybridPublicKey {
+	return &HybridPublicKey{
+		DH:    &prv.DH.PublicKey,
+		Kyber: prv.Kyber.EncapsulationKey(),
+	}
+}
+
+// Encapsulate generates an ephemeral DH keypair, computes a classical DH
+// shared secret against pub.DH, encapsulates against pub.Kyber, and
+// combines both secrets into size bytes of keying material, binding the
+// result to both ciphertexts so the two protocols can never be confused
+// with one another.
+func Encapsulate(rand io.Reader, pub *HybridPublicKey, size int) (ct *HybridCiphertext, ss []byte, err error) {
+	ephemeral, err := GenerateKey(rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	dhShared, err := ephemeral.SharedKeyConstantTime(rand, pub.DH, DefaultGroup.PubBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	kyberShared, kyberCT := pub.Kyber.Encapsulate()
+
+	ct = &HybridCiphertext{
+		DHPub:   ephemeral.Export(),
+		KyberCT: kyberCT,
+	}
+	ss = hybridKDF(dhShared, kyberShared, ct.DHPub, pub.DH.A.Bytes(), kyberCT, size)
+	return
+}
+
+// Decapsulate reconstructs the shared secret produced by the matching
+// call to Encapsulate.
+func (prv *HybridPrivateKey) Decapsulate(rand io.Reader, ct *HybridCiphertext, size int) (ss []byte, err error) {
+	dhPub, err := ImportPublic(ct.DHPub)
+	if err != nil {
+		return nil, ErrInvalidHybridCiphertext
+	}
+	dhShared, err := prv.DH.SharedKeyConstantTime(rand, dhPub, DefaultGroup.PubBytes)
+	if err != nil {
+		return nil, err
+	}
+	kyberShared, err := prv.Kyber.Decapsulate(ct.KyberCT)
+	if err != nil {
+		return nil, ErrInvalidHybridCiphertext
+	}
+	return hybridKDF(dhShared, kyberShared, ct.DHPub, prv.DH.Export(), ct.KyberCT, size), nil
+}
+
+// hybridKDF derives size bytes of keying material from the concatenation
+// of the classical and post-quantum shared secrets, binding both
+// ciphertexts into otherInfo in the same concatKDF style CEK uses.
+func hybridKDF(dhShared, kyberShared, dhPubA, dhPubB, kyberCT []byte, size int) (key []byte) {
+	zz := append(append([]byte{}, dhShared...), kyberShared...)
+	otherInfo := append(append(append([]byte{}, dhPubA...), dhPubB...), kyberCT...)
+
+	h := sha512.New()
+	counter := []byte{0, 0, 0, 1}
+
+	key = make([]byte, 0, size+h.Size())
+	for len(key) < size {
+		h.Reset()
+		h.Write(zz)
+		h.Write(counter)
+		h.Write(otherInfo)
+		key = h.Sum(key)
+		incCounter(counter)
+	}
+	return key[:size]
+}
+
+// hybridPublicKeyASN1 and hybridCiphertextASN1 are the DER encodings of
+// HybridPublicKey and HybridCiphertext respectively: a plain ASN.1
+// SEQUENCE of the two component byte strings.
+type hybridPublicKeyASN1 struct {
+	DHPub    []byte
+	KyberPub []byte
+}
+
+type hybridCiphertextASN1 struct {
+	DHPub   []byte
+	KyberCT []byte
+}
+
+// Export DER-encodes the hybrid public key as an ASN.1 SEQUENCE.
+func (pub *HybridPublicKey) Export() ([]byte, error) {
+	return asn1.Marshal(hybridPublicKeyASN1{
+		DHPub:    pub.DH.A.Bytes(),
+		KyberPub: pub.Kyber.Bytes(),
+	})
+}
+
+// ImportHybridPublic decodes a DER-encoded hybrid public key produced by
+// HybridPublicKey.Export.
+func ImportHybridPublic(in []byte) (*HybridPublicKey, error) {
+	var raw hybridPublicKeyASN1
+	if _, err := asn1.Unmarshal(in, &raw); err != nil {
+		return nil, ErrInvalidHybridPublicKey
+	}
+	dhPub, err := ImportPublic(raw.DHPub)
+	if err != nil {
+		return nil, err
+	}
+	kyberPub, err := mlkem.NewEncapsulationKey768(raw.KyberPub)
+	if err != nil {
+		return nil, ErrInvalidHybridPublicKey
+	}
+	return &HybridPublicKey{DH: dhPub, Kyber: kyberPub}, nil
+}
+
+// Export DER-encodes the hybrid ciphertext as an ASN.1 SEQUENCE.
+func (ct *HybridCiphertext) Export() ([]byte, error) {
+	return asn1.Marshal(hybridCiphertextASN1{DHPub: ct.DHPub, KyberCT: ct.KyberCT})
+}
+
+// ImportHybridCiphertext decodes a DER-encoded hybrid ciphertext produced
+// by HybridCiphertext.Export.
+func ImportHybridCiphertext(in []byte) (*HybridCiphertext, error) {
+	var raw hybridCiphertextASN1
+	if _, err := asn1.Unmarshal(in, &raw); err != nil {
+		return nil, ErrInvalidHybridCiphertext
+	}
+	return &HybridCiphertext{DHPub: raw.DHPub, KyberCT: raw.KyberCT}, nil
+}
@@ -0,0 +1,117 @@
+package dhkam
+
+import (
+	"crypto/hkdf"
+	"crypto/rand"
+	"fmt"
+	"hash"
+	"io"
+)
+
+var ErrInvalidKEMCiphertext = fmt.Errorf("dhkam: invalid KEM ciphertext")
+
+// KEM is a generic key-encapsulation mechanism: Encapsulate derives a
+// fresh shared secret against a recipient's public key along with a
+// ciphertext the recipient can use to recover it, and Decapsulate
+// reverses that with the recipient's private key. Keys and ciphertexts
+// are plain byte strings rather than dhkam's own PublicKey/PrivateKey
+// types, so the interface can be implemented equally by DHKEM or by an
+// unrelated post-quantum scheme such as ML-KEM, and composed into
+// constructions like HPKE that are written against KEM rather than
+// against any one algorithm.
+type KEM interface {
+	Encapsulate(rand io.Reader, pub []byte) (ct, ss []byte, err error)
+	Decapsulate(prv, ct []byte) (ss []byte, err error)
+	PublicKeySize() int
+	SecretSize() int
+	CiphertextSize() int
+}
+
+// DHKEM implements KEM over a dhkam Group, following the DHKEM
+// construction HPKE defines in RFC 9180 §4.1: the shared secret is
+// derived by running HKDF-Extract-and-Expand over the raw DH output,
+// with the concatenation of the ephemeral and recipient public keys as
+// the Expand step's info, binding the derived secret to both parties.
+// The ciphertext is simply the ephemeral public key, zero-padded to the
+// group's fixed PubBytes width.
+type DHKEM struct {
+	Group *Group
+	Hash  func() hash.Hash
+	Size  int
+}
+
+var _ KEM = (*DHKEM)(nil)
+
+// NewDHKEM builds a DHKEM deriving size bytes of shared secret for group,
+// using hash for both steps of HKDF.
+func NewDHKEM(group *Group, hash func() hash.Hash, size int) *DHKEM {
+	return &DHKEM{Group: group, Hash: hash, Size: size}
+}
+
+// PublicKeySize returns the fixed width of an encoded public key.
+func (k *DHKEM) PublicKeySize() int { return k.Group.PubBytes }
+
+// SecretSize returns the number of bytes Encapsulate and Decapsulate
+// derive as the shared secret.
+func (k *DHKEM) SecretSize() int { return k.Size }
+
+// CiphertextSize returns the fixed width of an Encapsulate ciphertext.
+func (k *DHKEM) CiphertextSize() int { return k.Group.PubBytes }
+
+// Encapsulate generates an ephemeral DH keypair in k.Group, computes its
+// shared secret against pub, and derives k.Size bytes of keying material
+// from it. ct is the ephemeral public key; the caller sends it to the
+// holder of prv alongside whatever ct protects.
+func (k *DHKEM) Encapsulate(prng io.Reader, pub []byte) (ct, ss []byte, err error) {
+	recipient, err := ImportPublicInGroup(k.Group, pub)
+	if err != nil {
+		return nil, nil, ErrInvalidPublicKey
+	}
+	ephemeral, err := GenerateKeyInGroup(prng, k.Group)
+	if err != nil {
+		return nil, nil, err
+	}
+	dh, err := ephemeral.SharedKeyConstantTime(prng, recipient, k.Group.PubBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ct = zeroPad(ephemeral.Export(), k.Group.PubBytes)
+	ss, err = k.extractAndExpand(dh, ct, zeroPad(pub, k.Group.PubBytes))
+	return
+}
+
+// Decapsulate recovers the shared secret Encapsulate derived, given the
+// recipient's private key and the ephemeral public key it produced as
+// ct. It draws its own randomness from crypto/rand for the blinding done
+// by ImportPrivateInGroup and SharedKeyConstantTime, matching the ambient-randomness convention Decapsulate
+// implementations such as crypto/mlkem's already use.
+func (k *DHKEM) Decapsulate(prv, ct []byte) (ss []byte, err error) {
+	recipient, err := ImportPrivateInGroup(rand.Reader, k.Group, prv)
+	if err != nil {
+		return nil, err
+	}
+	ephemeralPub, err := ImportPublicInGroup(k.Group, ct)
+	if err != nil {
+		return nil, ErrInvalidKEMCiphertext
+	}
+	dh, err := recipient.SharedKeyConstantTime(rand.Reader, ephemeralPub, k.Group.PubBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	pub := zeroPad(recipient.Export(), k.Group.PubBytes)
+	return k.extractAndExpand(dh, zeroPad(ct, k.Group.PubBytes), pub)
+}
+
+// extractAndExpand runs the HKDF-Extract-and-Expand half of DHKEM: dh is
+// extracted as the IKM, and ephemeralPub||recipientPub is bound in as the
+// Expand step's info so the derived secret commits to both parties.
+func (k *DHKEM) extractAndExpand(dh, ephemeralPub, recipientPub []byte) ([]byte, error) {
+	prk, err := hkdf.Extract(k.Hash, dh, nil)
+	if err != nil {
+		return nil, err
+	}
+	info := string(ephemeralPub) + string(recipientPub)
+	return hkdf.Expand(k.Hash, prk, info, k.Size)
+}
@@ -0,0 +1,67 @@
+package dhkam
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+)
+
+const kemSharedSecretSize = 32
+
+// TestDHKEMEncapsulateDecapsulate generates a recipient keypair,
+// encapsulates against its public key, and confirms Decapsulate on the
+// private key recovers the same shared secret.
+func TestDHKEMEncapsulateDecapsulate(t *testing.T) {
+	kem := NewDHKEM(Group14, sha256.New, kemSharedSecretSize)
+
+	prv, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	ct, ss1, err := kem.Encapsulate(rand.Reader, prv.Export())
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+	if len(ct) != kem.CiphertextSize() {
+		fmt.Println("dhkam: unexpected DHKEM ciphertext size")
+		t.FailNow()
+	}
+	if len(ss1) != kem.SecretSize() {
+		fmt.Println("dhkam: unexpected DHKEM secret size")
+		t.FailNow()
+	}
+
+	ss2, err := kem.Decapsulate(prv.ExportPrivate(), ct)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	if !bytes.Equal(ss1, ss2) {
+		fmt.Println("dhkam: DHKEM shared secrets don't match")
+		t.FailNow()
+	}
+}
+
+// TestDHKEMDecapsulateRejectsInvalidCiphertext confirms Decapsulate
+// refuses a ciphertext that isn't a valid encoded public key.
+func TestDHKEMDecapsulateRejectsInvalidCiphertext(t *testing.T) {
+	kem := NewDHKEM(Group14, sha256.New, kemSharedSecretSize)
+
+	prv, err := GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+		t.FailNow()
+	}
+
+	bogus := make([]byte, kem.CiphertextSize())
+	if _, err := kem.Decapsulate(prv.ExportPrivate(), bogus); err == nil {
+		fmt.Println("dhkam: Decapsulate accepted an invalid ciphertext")
+		t.FailNow()
+	}
+}